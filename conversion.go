@@ -0,0 +1,57 @@
+package pyfmt
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// conversions holds the single-character converters usable as a "!x" segment before a
+// placeholder's format spec, e.g. "{value!r:>20}". conversionsMu guards concurrent
+// RegisterConversion calls racing with formatting.
+var (
+	conversionsMu sync.RWMutex
+	conversions   = map[byte]func(interface{}) (interface{}, error){
+		's': func(v interface{}) (interface{}, error) { return fmt.Sprint(v), nil },
+		'r': func(v interface{}) (interface{}, error) { return fmt.Sprintf("%#v", v), nil },
+		'a': func(v interface{}) (interface{}, error) { return asciiEscape(v), nil },
+	}
+)
+
+// RegisterConversion installs a converter for the "!name" segment of a placeholder, e.g.
+// RegisterConversion('j', toJSON) enables "{value!j}". The converter's result replaces the
+// argument before any alignment, width, or precision handling in the format spec runs. Built-in
+// names are "s" (fmt.Sprint), "r" (Go's %#v) and "a" (ASCII-only, escaping non-ASCII runes as
+// \uXXXX); registering one of those overrides the built-in.
+func RegisterConversion(name byte, fn func(interface{}) (interface{}, error)) {
+	conversionsMu.Lock()
+	defer conversionsMu.Unlock()
+	conversions[name] = fn
+}
+
+// applyConversion runs the converter registered for name against val.
+func applyConversion(name byte, val interface{}) (interface{}, error) {
+	conversionsMu.RLock()
+	fn, ok := conversions[name]
+	conversionsMu.RUnlock()
+	if !ok {
+		return nil, Error("unknown conversion !{}", string(name))
+	}
+	return fn(val)
+}
+
+// asciiEscape renders v like fmt.Sprint, but escapes every rune outside the ASCII range as
+// \uXXXX (or \UXXXXXXXX for runes beyond the basic multilingual plane), mirroring Python's !a.
+func asciiEscape(v interface{}) string {
+	var b strings.Builder
+	for _, r := range fmt.Sprint(v) {
+		if r <= 0x7F {
+			b.WriteRune(r)
+		} else if r <= 0xFFFF {
+			fmt.Fprintf(&b, "\\u%04x", r)
+		} else {
+			fmt.Fprintf(&b, "\\U%08x", r)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,57 @@
+package pyfmt
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestConversionBuiltins(t *testing.T) {
+	tests := []struct {
+		format string
+		arg    interface{}
+		want   string
+	}{
+		{"{!s}", 42, "42"},
+		{"{!r}", "hi", `"hi"`},
+		{"{!a}", "café", "caf\\u00e9"},
+	}
+	for _, tt := range tests {
+		got, err := Fmt(tt.format, tt.arg)
+		if err != nil {
+			t.Errorf("Fmt(%q, %v) returned error: %v", tt.format, tt.arg, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Fmt(%q, %v) = %q, want %q", tt.format, tt.arg, got, tt.want)
+		}
+	}
+}
+
+func TestConversionMalformed(t *testing.T) {
+	if _, err := Fmt("{!rs}", "hello"); err == nil {
+		t.Error("Fmt with multi-character conversion returned no error")
+	}
+}
+
+func TestConversionUnknown(t *testing.T) {
+	if _, err := Fmt("{!z}", "hello"); err == nil {
+		t.Error("Fmt with unregistered conversion returned no error")
+	}
+}
+
+func TestRegisterConversionOverride(t *testing.T) {
+	RegisterConversion('s', func(v interface{}) (interface{}, error) {
+		return "overridden", nil
+	})
+	defer RegisterConversion('s', func(v interface{}) (interface{}, error) {
+		return fmt.Sprint(v), nil
+	})
+
+	got, err := Fmt("{!s}", "hello")
+	if err != nil {
+		t.Fatalf("Fmt returned error: %v", err)
+	}
+	if got != "overridden" {
+		t.Errorf("Fmt with overridden conversion = %q, want %q", got, "overridden")
+	}
+}
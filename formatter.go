@@ -0,0 +1,99 @@
+package pyfmt
+
+// Formatter is implemented by types that want to control their own Python-style formatting,
+// analogous to fmt.Formatter. When a value passed to Fmt implements Formatter, render() dispatches
+// to PyFormat instead of falling through to fmt.Sprintf, passing along the width, precision, fill
+// character, alignment, and flags parsed from the placeholder's format spec.
+type Formatter interface {
+	// PyFormat writes the formatted representation of the receiver to state, honoring as much of
+	// verb (e.g. "f", "d", "%") and state's width/precision/align/fill/flags as makes sense for
+	// the type.
+	PyFormat(state State, verb string) error
+}
+
+// State is passed to Formatter.PyFormat, giving it access to the output buffer and the parsed
+// format spec, the same role fmt.State plays for fmt.Formatter.
+type State interface {
+	// Write writes b to the underlying buffer.
+	Write(b []byte) (n int, err error)
+	// Width returns the value of the width option and whether it was set.
+	Width() (wid int, ok bool)
+	// Precision returns the value of the precision option and whether it was set.
+	Precision() (prec int, ok bool)
+	// Fill returns the fill rune to pad with, defaulting to ' ' when none was set.
+	Fill() rune
+	// Align returns the alignment rune ('<', '>', '=', '^'), or 0 if none was set.
+	Align() rune
+	// Flag reports whether the given flag is in effect, one of '#', '+', '-', ' ', '0'. As with
+	// fmt.State, '-' reports left alignment and '0' reports a '0' fill character.
+	Flag(c byte) bool
+}
+
+// formatState is the concrete State implementation backing a Formatter dispatch.
+type formatState struct {
+	buf *buffer
+
+	width    int64
+	hasWidth bool
+
+	precision    int64
+	hasPrecision bool
+
+	fill  rune
+	align int
+	sign  string
+	radix bool
+}
+
+func (s *formatState) Write(b []byte) (int, error) {
+	s.buf.WriteString(string(b))
+	if s.buf.err != nil {
+		return 0, s.buf.err
+	}
+	return len(b), nil
+}
+
+func (s *formatState) Width() (int, bool) {
+	return int(s.width), s.hasWidth
+}
+
+func (s *formatState) Precision() (int, bool) {
+	return int(s.precision), s.hasPrecision
+}
+
+func (s *formatState) Fill() rune {
+	if s.fill == 0 {
+		return ' '
+	}
+	return s.fill
+}
+
+func (s *formatState) Align() rune {
+	switch s.align {
+	case left:
+		return '<'
+	case right:
+		return '>'
+	case padSign:
+		return '='
+	case center:
+		return '^'
+	}
+	return 0
+}
+
+func (s *formatState) Flag(c byte) bool {
+	switch c {
+	case '#':
+		return s.radix
+	case '+':
+		return s.sign == "+"
+	case '-':
+		return s.align == left
+	case ' ':
+		return s.sign == " "
+	case '0':
+		return s.fill == '0'
+	}
+	return false
+}
@@ -0,0 +1,65 @@
+package pyfmt
+
+import (
+	"fmt"
+	"testing"
+)
+
+// upper implements Formatter, writing its value upper-cased and honoring width via State.
+type upper string
+
+func (u upper) PyFormat(state State, verb string) error {
+	s := fmt.Sprintf("%s", string(u))
+	out := []byte{}
+	for _, r := range s {
+		if r >= 'a' && r <= 'z' {
+			r -= 'a' - 'A'
+		}
+		out = append(out, byte(r))
+	}
+	if width, ok := state.Width(); ok && width > len(out) {
+		pad := make([]byte, width-len(out))
+		for i := range pad {
+			pad[i] = byte(state.Fill())
+		}
+		if state.Align() == '>' {
+			out = append(pad, out...)
+		} else {
+			out = append(out, pad...)
+		}
+	}
+	_, err := state.Write(out)
+	return err
+}
+
+func TestFormatterDispatch(t *testing.T) {
+	got, err := Fmt("{}", upper("hi"))
+	if err != nil {
+		t.Fatalf("Fmt returned error: %v", err)
+	}
+	if got != "HI" {
+		t.Errorf("Fmt(upper) = %q, want %q", got, "HI")
+	}
+}
+
+func TestFormatterDispatchWidthAndAlign(t *testing.T) {
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{"{:6}", "HI    "},
+		{"{:<6}", "HI    "},
+		{"{:>6}", "    HI"},
+		{"{:*>6}", "****HI"},
+	}
+	for _, tt := range tests {
+		got, err := Fmt(tt.format, upper("hi"))
+		if err != nil {
+			t.Errorf("Fmt(%q) returned error: %v", tt.format, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Fmt(%q) = %q, want %q", tt.format, got, tt.want)
+		}
+	}
+}
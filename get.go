@@ -0,0 +1,194 @@
+package pyfmt
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+const (
+	stepAttr = iota
+	stepIndex
+)
+
+// fieldStep is one ".attr" or "[index]" hop in a dotted field reference like
+// "items[0].Name" or "kwargs[key].SubField".
+type fieldStep struct {
+	kind int
+	name string
+}
+
+// parseField splits a field reference into its base (a bare name or positional index, same as
+// before dotted access existed) and the chain of attribute/index steps that follow it.
+func parseField(field string) (string, []fieldStep, error) {
+	i := 0
+	for i < len(field) && field[i] != '.' && field[i] != '[' {
+		i++
+	}
+	base := field[:i]
+	var steps []fieldStep
+	for i < len(field) {
+		switch field[i] {
+		case '.':
+			j := i + 1
+			for j < len(field) && field[j] != '.' && field[j] != '[' {
+				j++
+			}
+			if j == i+1 {
+				return "", nil, Error("empty attribute name in field {}", field)
+			}
+			steps = append(steps, fieldStep{kind: stepAttr, name: field[i+1 : j]})
+			i = j
+		case '[':
+			j := i + 1
+			for j < len(field) && field[j] != ']' {
+				j++
+			}
+			if j >= len(field) {
+				return "", nil, Error("unterminated '[' in field {}", field)
+			}
+			steps = append(steps, fieldStep{kind: stepIndex, name: field[i+1 : j]})
+			i = j + 1
+		default:
+			return "", nil, Error("unexpected character {} in field {}", string(field[i]), field)
+		}
+	}
+	return base, steps, nil
+}
+
+// getElement looks up the value that field refers to, either a bare name/positional index (as
+// before), or a Python-style dotted reference such as "user.Name", "items[0]", or
+// "kwargs[key].SubField". listPos is used when field resolves to the next positional argument.
+func getElement(field string, listPos int, args ...interface{}) (interface{}, error) {
+	base, steps, err := parseField(field)
+	if err != nil {
+		return nil, err
+	}
+	val, err := getBase(base, listPos, args...)
+	if err != nil {
+		return nil, err
+	}
+	for _, step := range steps {
+		val, err = applyStep(val, step)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return val, nil
+}
+
+// getBase resolves the leading part of a field reference: the empty string means "the next
+// positional argument", a plain integer means "that positional argument", and anything else
+// means "the named field or key found by searching the arguments' structs and maps".
+func getBase(name string, listPos int, args ...interface{}) (interface{}, error) {
+	if name == "" {
+		if listPos >= len(args) {
+			return nil, Error("not enough arguments for format string, have {}", len(args))
+		}
+		return args[listPos], nil
+	}
+	if idx, err := strconv.Atoi(name); err == nil {
+		if idx < 0 || idx >= len(args) {
+			return nil, Error("index {} out of range of {} arguments", idx, len(args))
+		}
+		return args[idx], nil
+	}
+	for _, a := range args {
+		v := indirect(reflect.ValueOf(a))
+		switch v.Kind() {
+		case reflect.Map:
+			if val, err := lookupMapKey(v, name); err == nil {
+				return val, nil
+			}
+		case reflect.Struct:
+			if val, err := lookupStructField(v, name); err == nil {
+				return val, nil
+			}
+		}
+	}
+	return nil, Error("no argument named {} found", name)
+}
+
+// applyStep walks one ".attr" or "[index]" hop from val.
+func applyStep(val interface{}, step fieldStep) (interface{}, error) {
+	v := indirect(reflect.ValueOf(val))
+	switch step.kind {
+	case stepAttr:
+		switch v.Kind() {
+		case reflect.Struct:
+			return lookupStructField(v, step.name)
+		case reflect.Map:
+			return lookupMapKey(v, step.name)
+		}
+		return nil, Error("no field {} on {}", step.name, v.Kind())
+	case stepIndex:
+		switch v.Kind() {
+		case reflect.Slice, reflect.Array:
+			idx, err := strconv.Atoi(step.name)
+			if err != nil {
+				return nil, Error("invalid index {} into {}", step.name, v.Type())
+			}
+			if idx < 0 || idx >= v.Len() {
+				return nil, Error("index {} out of range on {} of len {}", idx, v.Type(), v.Len())
+			}
+			return v.Index(idx).Interface(), nil
+		case reflect.Map:
+			return lookupMapKey(v, step.name)
+		}
+		return nil, Error("can't index into {}", v.Kind())
+	}
+	return nil, Error("unreachable field step")
+}
+
+// lookupStructField finds a field by name, falling back to a case-insensitive match. Unexported
+// fields are never readable via reflection, so they're treated the same as a missing field rather
+// than panicking on Interface().
+func lookupStructField(v reflect.Value, name string) (interface{}, error) {
+	f := v.FieldByName(name)
+	if f.IsValid() && f.CanInterface() {
+		return f.Interface(), nil
+	}
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		if strings.EqualFold(t.Field(i).Name, name) && v.Field(i).CanInterface() {
+			return v.Field(i).Interface(), nil
+		}
+	}
+	return nil, Error("no field {} on {}", name, t)
+}
+
+// lookupMapKey finds a value by key, converting key (always a string as parsed out of the format
+// string) to the map's key type when it's a signed integer kind. Maps with any other key type,
+// unsigned integers included, are rejected with an error.
+func lookupMapKey(v reflect.Value, key string) (interface{}, error) {
+	keyType := v.Type().Key()
+	var keyVal reflect.Value
+	switch keyType.Kind() {
+	case reflect.String:
+		keyVal = reflect.ValueOf(key)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(key, 10, 64)
+		if err != nil {
+			return nil, Error("can't use {} as a key for {}", key, v.Type())
+		}
+		keyVal = reflect.ValueOf(n).Convert(keyType)
+	default:
+		return nil, Error("unsupported map key type {} for {}", keyType, v.Type())
+	}
+	result := v.MapIndex(keyVal)
+	if !result.IsValid() {
+		return nil, Error("no key {} in {}", key, v.Type())
+	}
+	return result.Interface(), nil
+}
+
+// indirect dereferences pointers and interfaces until it reaches the underlying value.
+func indirect(v reflect.Value) reflect.Value {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return v
+		}
+		v = v.Elem()
+	}
+	return v
+}
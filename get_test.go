@@ -0,0 +1,84 @@
+package pyfmt
+
+import "testing"
+
+type innerUser struct {
+	Name string
+	age  int
+}
+
+type outerUser struct {
+	User innerUser
+	Tags []string
+	Meta map[string]int
+}
+
+func TestGetElementDottedAccess(t *testing.T) {
+	u := outerUser{
+		User: innerUser{Name: "Alice", age: 30},
+		Tags: []string{"admin", "ops"},
+		Meta: map[string]int{"score": 7},
+	}
+
+	tests := []struct {
+		field string
+		want  interface{}
+	}{
+		{"user.User.Name", "Alice"},
+		{"user.Tags[0]", "admin"},
+		{"user.Tags[1]", "ops"},
+		{"user.Meta[score]", 7},
+		{"user.user.name", "Alice"}, // case-insensitive fallback
+	}
+	for _, tt := range tests {
+		got, err := getElement(tt.field, 0, map[string]interface{}{"user": u})
+		if err != nil {
+			t.Errorf("getElement(%q) returned error: %v", tt.field, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("getElement(%q) = %v, want %v", tt.field, got, tt.want)
+		}
+	}
+}
+
+func TestGetElementIndexOutOfRange(t *testing.T) {
+	u := outerUser{Tags: []string{"admin"}}
+	_, err := getElement("user.Tags[5]", 0, map[string]interface{}{"user": u})
+	if err == nil {
+		t.Fatal("getElement with out-of-range index returned no error")
+	}
+}
+
+func TestGetElementUnexportedField(t *testing.T) {
+	u := innerUser{Name: "Alice", age: 30}
+	_, err := getElement("user.age", 0, map[string]interface{}{"user": u})
+	if err == nil {
+		t.Fatal("getElement on unexported field returned no error")
+	}
+}
+
+func TestGetElementMapKeyCoercion(t *testing.T) {
+	m := map[int]string{1: "one", 2: "two"}
+	got, err := getElement("m[1]", 0, map[string]interface{}{"m": m})
+	if err != nil {
+		t.Fatalf("getElement returned error: %v", err)
+	}
+	if got != "one" {
+		t.Errorf("getElement(%q) = %v, want %v", "m[1]", got, "one")
+	}
+}
+
+func TestGetElementPositional(t *testing.T) {
+	got, err := getElement("0", 0, "first", "second")
+	if err != nil {
+		t.Fatalf("getElement returned error: %v", err)
+	}
+	if got != "first" {
+		t.Errorf("getElement(%q) = %v, want %v", "0", got, "first")
+	}
+
+	if _, err := getElement("5", 0, "first"); err == nil {
+		t.Fatal("getElement with out-of-range positional index returned no error")
+	}
+}
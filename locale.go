@@ -0,0 +1,172 @@
+package pyfmt
+
+import (
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// locale holds the punctuation a numeric formatter needs to render a number the way a given
+// language/region expects it: which rune separates the integer part from the fraction, which
+// rune (if any) separates groups of digits, how those groups are sized counting outward from the
+// decimal point, and which string marks a percentage.
+type locale struct {
+	decimal string
+	group   string
+	// groupSizes gives the size of each digit group, starting from the one nearest the decimal
+	// point. The last entry repeats for any remaining digits, so {3} groups every three digits
+	// and {3, 2} gives the Indian style, e.g. 1,00,000.
+	groupSizes []int
+	percent    string
+}
+
+// defaultLocale is used when no Printer is involved, e.g. plain Fmt calls that opt into grouping
+// via the "," mini-language flag.
+var defaultLocale = locale{decimal: ".", group: ",", groupSizes: []int{3}, percent: "%"}
+
+// localeTable maps a base language to its numeric punctuation. This is intentionally a small,
+// hand-picked set rather than a full CLDR import; extend it as callers need more locales.
+var localeTable = map[string]locale{
+	"en": {decimal: ".", group: ",", groupSizes: []int{3}, percent: "%"},
+	"de": {decimal: ",", group: ".", groupSizes: []int{3}, percent: "%"},
+	"fr": {decimal: ",", group: " ", groupSizes: []int{3}, percent: "%"},
+	"hi": {decimal: ".", group: ",", groupSizes: []int{3, 2}, percent: "%"},
+}
+
+// localeFor resolves a language.Tag to its numeric punctuation, falling back to English-style
+// formatting for languages we don't have an entry for.
+func localeFor(tag language.Tag) locale {
+	base, _ := tag.Base()
+	if loc, ok := localeTable[base.String()]; ok {
+		return loc
+	}
+	return localeTable["en"]
+}
+
+// groupDigits inserts sep between groups of digits, sized from the right according to sizes. The
+// last size in sizes repeats for any digits beyond the groups it explicitly describes.
+func groupDigits(digits string, sizes []int, sep string) string {
+	if sep == "" || len(digits) == 0 {
+		return digits
+	}
+	if len(sizes) == 0 {
+		sizes = []int{3}
+	}
+	var groups []string
+	i := len(digits)
+	sizeIdx := 0
+	for i > 0 {
+		size := sizes[sizeIdx]
+		if size <= 0 {
+			size = len(digits)
+		}
+		if sizeIdx < len(sizes)-1 {
+			sizeIdx++
+		}
+		start := i - size
+		if start < 0 {
+			start = 0
+		}
+		groups = append([]string{digits[start:i]}, groups...)
+		i = start
+	}
+	return strings.Join(groups, sep)
+}
+
+// groupSeparatorCount returns how many separators grouping d digits produces under sizes, i.e.
+// one fewer than the number of groups.
+func groupSeparatorCount(d int, sizes []int) int {
+	if d <= 0 {
+		return 0
+	}
+	if len(sizes) == 0 {
+		sizes = []int{3}
+	}
+	groups := 0
+	i := d
+	sizeIdx := 0
+	for i > 0 {
+		size := sizes[sizeIdx]
+		if size <= 0 {
+			size = d
+		}
+		if sizeIdx < len(sizes)-1 {
+			sizeIdx++
+		}
+		i -= size
+		groups++
+	}
+	return groups - 1
+}
+
+// zeroPadDigitCount returns the smallest digit count >= curLen whose grouped-with-separators
+// length reaches targetTotal. Zero-extending to that many digits and then grouping gives exactly
+// targetTotal characters, or one more where exactly targetTotal would start with a separator
+// (e.g. 0,000,001,234 for a target of 12). This matches Python's str.format, which zero-pads the
+// digit run *before* inserting grouping separators, counting the separators against the width.
+func zeroPadDigitCount(curLen, targetTotal int, sizes []int) int {
+	d := curLen
+	for d+groupSeparatorCount(d, sizes) < targetTotal {
+		d++
+	}
+	return d
+}
+
+// applyLocale rewrites the digit run produced by fmt.Sprintf to use loc's separators, grouping
+// the integer part when group is true. str may still carry a leading sign, which is passed
+// through untouched, and an exponent suffix or a trailing '%', which are never grouped or touched
+// beyond being reattached.
+//
+// zeroFillWidth is the target total width when zero-fill padding is in play (render() clears
+// fmt.Sprintf's own width handling in that case so grouping separators don't eat into the
+// requested zero count); pass 0 when no zero-fill padding applies.
+func applyLocale(str string, loc locale, group bool, zeroFillWidth int) string {
+	sign := ""
+	if len(str) > 0 && (str[0] == '-' || str[0] == '+' || str[0] == ' ') {
+		sign = str[:1]
+		str = str[1:]
+	}
+	percent := ""
+	if strings.HasSuffix(str, "%") {
+		percent = "%"
+		str = str[:len(str)-1]
+	}
+	mantissa := str
+	exponent := ""
+	if idx := strings.IndexAny(str, "eE"); idx >= 0 {
+		mantissa = str[:idx]
+		exponent = str[idx:]
+	}
+	intPart := mantissa
+	fracPart := ""
+	hasFrac := false
+	if idx := strings.IndexByte(mantissa, '.'); idx >= 0 {
+		intPart = mantissa[:idx]
+		fracPart = mantissa[idx+1:]
+		hasFrac = true
+	}
+	if zeroFillWidth > 0 {
+		fixedLen := len(sign) + len(exponent) + len(percent)
+		if hasFrac {
+			fixedLen += len(loc.decimal) + len(fracPart)
+		}
+		needed := zeroFillWidth - fixedLen
+		if needed > len(intPart) {
+			d := needed
+			if group {
+				d = zeroPadDigitCount(len(intPart), needed, loc.groupSizes)
+			}
+			if d > len(intPart) {
+				intPart = strings.Repeat("0", d-len(intPart)) + intPart
+			}
+		}
+	}
+	if group {
+		intPart = groupDigits(intPart, loc.groupSizes, loc.group)
+	}
+	out := sign + intPart
+	if hasFrac {
+		out += loc.decimal + fracPart
+	}
+	return out + exponent + percent
+}
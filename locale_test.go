@@ -0,0 +1,64 @@
+package pyfmt
+
+import (
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+// These cases are checked against the output of Python's str.format/format() for the same spec.
+func TestLocaleGrouping(t *testing.T) {
+	tests := []struct {
+		format string
+		arg    interface{}
+		want   string
+	}{
+		{"{:,d}", 1234567, "1,234,567"},
+		{"{:,d}", -1234567, "-1,234,567"},
+		{"{:,.2f}", 1234.5, "1,234.50"},
+		{"{:,.2f}", -1234.5, "-1,234.50"},
+		{"{:>20,.2f}", 1234.5, "            1,234.50"},
+		{"{:.2%}", 0.1234, "12.34%"},
+		{"{:,.2%}", 1234.5, "123,450.00%"},
+		// Zero-fill padding must count grouping separators against the requested width, the same
+		// way Python's str.format does.
+		{"{:015,d}", 1234567, "000,001,234,567"},
+		{"{:015,d}", -1234567, "-00,001,234,567"},
+		{"{:015,.2f}", 1234.5, "0,000,001,234.50"},
+	}
+	for _, tt := range tests {
+		got, err := Fmt(tt.format, tt.arg)
+		if err != nil {
+			t.Errorf("Fmt(%q, %v) returned error: %v", tt.format, tt.arg, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Fmt(%q, %v) = %q, want %q", tt.format, tt.arg, got, tt.want)
+		}
+	}
+}
+
+func TestPrinterLocale(t *testing.T) {
+	tests := []struct {
+		tag    string
+		format string
+		arg    interface{}
+		want   string
+	}{
+		{"de", "{:,.2f}", 1234.5, "1.234,50"},
+		{"fr", "{:,d}", 1234567, "1 234 567"},
+		{"hi", "{:,d}", 100000, "1,00,000"},
+		{"hi", "{:015,d}", 100000, "00,00,01,00,000"},
+	}
+	for _, tt := range tests {
+		p := NewPrinter(language.MustParse(tt.tag))
+		got, err := p.Fmt(tt.format, tt.arg)
+		if err != nil {
+			t.Errorf("Printer(%s).Fmt(%q, %v) returned error: %v", tt.tag, tt.format, tt.arg, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Printer(%s).Fmt(%q, %v) = %q, want %q", tt.tag, tt.format, tt.arg, got, tt.want)
+		}
+	}
+}
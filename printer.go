@@ -0,0 +1,50 @@
+package pyfmt
+
+import (
+	"errors"
+
+	"golang.org/x/text/language"
+)
+
+// Printer is a pyfmt formatter bound to a language.Tag, analogous to
+// golang.org/x/text/message.Printer. Numeric verbs (d, f, F, g, G, e, E, % and the default
+// numeric v) are rendered using that tag's decimal separator, grouping separator, grouping size,
+// and percent symbol instead of Go's fmt.Sprintf defaults. Digits are grouped only when the format
+// spec carries the "," option, e.g. "{:,d}".
+type Printer struct {
+	loc locale
+}
+
+// NewPrinter returns a Printer that renders numbers the way tag expects them to look.
+func NewPrinter(tag language.Tag) *Printer {
+	return &Printer{loc: localeFor(tag)}
+}
+
+// Fmt is the equivalent of Fmt, but renders numeric verbs using p's locale.
+func (p *Printer) Fmt(format string, a ...interface{}) (string, error) {
+	f := newFormater(&p.loc)
+	defer f.free()
+	f.args = a
+	if err := f.doFormat(format); err != nil {
+		return "", err
+	}
+	return f.buf.String(), nil
+}
+
+// Must is like Fmt, but panics on error.
+func (p *Printer) Must(format string, a ...interface{}) string {
+	s, err := p.Fmt(format, a...)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// Error is like Fmt, but returns an error.
+func (p *Printer) Error(format string, a ...interface{}) error {
+	s, err := p.Fmt(format, a...)
+	if err != nil {
+		return Error("error formatting {}: {}", s, err)
+	}
+	return errors.New(s)
+}
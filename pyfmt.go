@@ -2,14 +2,45 @@ package pyfmt
 
 import (
 	"errors"
+	"io"
 	"strings"
+	"sync"
 )
 
-// Using a simple []byte instead of bytes.Buffer to avoid the dependency.
-type buffer []byte
+// buffer is the sink render.render() writes into. It either accumulates bytes in memory (the
+// buf field, as before) or, when w is set, flushes each WriteString straight through to an
+// io.Writer, so Fprint/Fprintln can stream without materializing the whole result string.
+type buffer struct {
+	buf     []byte
+	w       io.Writer
+	written int
+	err     error
+}
 
 func (b *buffer) WriteString(s string) {
-	*b = append(*b, s...)
+	if b.err != nil {
+		return
+	}
+	if b.w != nil {
+		n, err := io.WriteString(b.w, s)
+		b.written += n
+		b.err = err
+		return
+	}
+	b.buf = append(b.buf, s...)
+	b.written += len(s)
+}
+
+func (b *buffer) String() string {
+	return string(b.buf)
+}
+
+// reset clears b so it can be reused for another doFormat call, keeping the backing array of buf.
+func (b *buffer) reset() {
+	b.buf = b.buf[:0]
+	b.w = nil
+	b.written = 0
+	b.err = nil
 }
 
 const (
@@ -73,13 +104,39 @@ type ff struct {
 	r render
 }
 
-// newFormater creates a new ff struct.
-// TODO(slongfield): Investigate using a sync.Pool to avoid reallocation.
-func newFormater() *ff {
-	f := ff{}
+// ffPool recycles ff values across Fmt calls, following the pattern of fmt package's ppFree.
+var ffPool = sync.Pool{
+	New: func() interface{} { return new(ff) },
+}
+
+// maxPooledBufCap caps the buffer capacity we'll keep around in the pool, so that one very large
+// Fmt call doesn't pin that memory for every future caller.
+const maxPooledBufCap = 64 * 1024
+
+// newFormater acquires an ff from ffPool, optionally bound to a locale for locale-aware numeric
+// rendering. A nil loc means "no Printer", i.e. fall back to "," and "." if grouping is requested.
+// Callers must return the ff with free() once they're done with it.
+func newFormater(loc *locale) *ff {
+	f := ffPool.Get().(*ff)
+	f.buf.reset()
+	f.args = nil
 	f.listPos = 0
 	f.r.init(&f.buf)
-	return &f
+	f.r.locale = loc
+	return f
+}
+
+// free resets f's references to the caller's data and returns it to ffPool.
+func (f *ff) free() {
+	if cap(f.buf.buf) > maxPooledBufCap {
+		f.buf.buf = nil
+	}
+	f.buf.w = nil
+	f.buf.err = nil
+	f.args = nil
+	f.r.val = nil
+	f.r.locale = nil
+	ffPool.Put(f)
 }
 
 // doFormat parses the string, and executes a format command. Stores the output in ff's buf.
@@ -122,12 +179,19 @@ func (f *ff) doFormat(format string) error {
 			return errors.New("Single '{' encountered in format string")
 		}
 		field := format[cachei:i]
-		var err error
-		name, format := splitFormat(field)
+		name, conv, format, err := splitFormat(field)
+		if err != nil {
+			return err
+		}
 		f.r.val, err = f.getArg(name)
 		if err != nil {
 			return err
 		}
+		if conv != 0 {
+			if f.r.val, err = applyConversion(conv, f.r.val); err != nil {
+				return err
+			}
+		}
 		f.r.clearFlags()
 		if err = f.r.parseFlags(format); err != nil {
 			return err
@@ -140,12 +204,25 @@ func (f *ff) doFormat(format string) error {
 	return nil
 }
 
-func splitFormat(field string) (string, string) {
+// splitFormat splits a placeholder's contents into its field name, optional "!x" conversion
+// character, and the format spec that follows the ":". e.g. "value!r:>20" splits into "value",
+// 'r', ">20". It's an error for "!" to be present without exactly one character following it.
+func splitFormat(field string) (string, byte, string, error) {
 	s := strings.SplitN(field, ":", 2)
-	if len(s) == 1 {
-		return s[0], ""
+	name := s[0]
+	spec := ""
+	if len(s) == 2 {
+		spec = s[1]
 	}
-	return s[0], s[1]
+	var conv byte
+	if idx := strings.IndexByte(name, '!'); idx >= 0 {
+		if idx+2 != len(name) {
+			return "", 0, "", Error("invalid conversion {}, want a single character after !", name[idx:])
+		}
+		conv = name[idx+1]
+		name = name[:idx]
+	}
+	return name, conv, spec, nil
 }
 
 func (f *ff) getArg(argName string) (interface{}, error) {
@@ -159,14 +236,40 @@ func (f *ff) getArg(argName string) (interface{}, error) {
 // Fmt is the equivlent of Python's string.format() function. Takes a list of possible elements
 // to use in formatting, and substitutes them.
 func Fmt(format string, a ...interface{}) (string, error) {
-	f := newFormater()
+	f := newFormater(nil)
+	defer f.free()
 	f.args = a
 	err := f.doFormat(format)
 	if err != nil {
 		return "", err
 	}
-	s := string(f.buf)
-	return s, nil
+	return f.buf.String(), nil
+}
+
+// Fprint is like Fmt, but writes the result directly to w instead of building a string, avoiding
+// the extra copy that Fmt's f.buf.String() forces. It returns the number of bytes written and the
+// first error encountered, either from formatting or from w.
+func Fprint(w io.Writer, format string, a ...interface{}) (int, error) {
+	f := newFormater(nil)
+	defer f.free()
+	f.buf.w = w
+	f.args = a
+	err := f.doFormat(format)
+	if f.buf.err != nil {
+		// doFormat stops at its first error, so a write error recorded by then came first.
+		return f.buf.written, f.buf.err
+	}
+	return f.buf.written, err
+}
+
+// Fprintln is like Fprint, but appends a trailing newline.
+func Fprintln(w io.Writer, format string, a ...interface{}) (int, error) {
+	n, err := Fprint(w, format, a...)
+	if err != nil {
+		return n, err
+	}
+	m, err := io.WriteString(w, "\n")
+	return n + m, err
 }
 
 // Must is like Fmt, but panics on error.
@@ -0,0 +1,15 @@
+package pyfmt
+
+import "testing"
+
+// BenchmarkFmt exercises a typical short format string, the case the ff sync.Pool helps with: each
+// call reuses a pooled ff and its buffer; the allocations still reported come from argument
+// handling, per-placeholder rendering, and the returned string.
+func BenchmarkFmt(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := Fmt("{} is {} years old", "Alice", 30); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
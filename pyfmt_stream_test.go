@@ -0,0 +1,50 @@
+package pyfmt
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestFprint(t *testing.T) {
+	var buf bytes.Buffer
+	n, err := Fprint(&buf, "{} is {} years old", "Alice", 30)
+	if err != nil {
+		t.Fatalf("Fprint returned error: %v", err)
+	}
+	want := "Alice is 30 years old"
+	if buf.String() != want {
+		t.Errorf("Fprint wrote %q, want %q", buf.String(), want)
+	}
+	if n != len(want) {
+		t.Errorf("Fprint returned n = %d, want %d", n, len(want))
+	}
+}
+
+func TestFprintln(t *testing.T) {
+	var buf bytes.Buffer
+	n, err := Fprintln(&buf, "{}", "hi")
+	if err != nil {
+		t.Fatalf("Fprintln returned error: %v", err)
+	}
+	want := "hi\n"
+	if buf.String() != want {
+		t.Errorf("Fprintln wrote %q, want %q", buf.String(), want)
+	}
+	if n != len(want) {
+		t.Errorf("Fprintln returned n = %d, want %d", n, len(want))
+	}
+}
+
+type errWriter struct{}
+
+func (errWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("boom")
+}
+
+func TestFprintWriterError(t *testing.T) {
+	_, err := Fprint(errWriter{}, "{}", "hi")
+	if err == nil {
+		t.Fatal("Fprint with a failing writer returned no error")
+	}
+}
@@ -3,6 +3,7 @@ package pyfmt
 import (
 	"errors"
 	"fmt"
+	"reflect"
 	"strconv"
 	"strings"
 	"unicode/utf8"
@@ -14,9 +15,14 @@ type flags struct {
 	sign       string
 	showRadix  bool
 	minWidth   string
+	grouping   string
 	precision  string
 	renderVerb string
 	percent    bool
+
+	// verb is the raw verb letter the caller wrote (e.g. "%" or "s"), kept around for
+	// pyfmt.Formatter dispatch since renderVerb is translated into the underlying fmt verb.
+	verb string
 }
 
 // Render is the renderer used to render dispatched format strings into a buffer that's been set up
@@ -25,6 +31,11 @@ type render struct {
 	buf *buffer
 	val interface{}
 
+	// locale holds the locale-specific separators to use when rendering numeric verbs. It's nil
+	// when the format call isn't going through a Printer, in which case grouping (if requested)
+	// falls back to "," and ".".
+	locale *locale
+
 	flags
 }
 
@@ -44,6 +55,7 @@ const (
 	radixState
 	zeroState
 	widthState
+	groupingState
 	precisionState
 	verbState
 	endState
@@ -63,7 +75,7 @@ var isDigit = map[byte]struct{}{
 
 // splitFlags splits out the flags into the various fields. This replaces the previous regex parser
 // (see render_test.go for regex)
-func splitFlags(flags string) (align, sign, radix, zeroPad, minWidth, precision, verb string, err error) {
+func splitFlags(flags string) (align, sign, radix, zeroPad, minWidth, grouping, precision, verb string, err error) {
 	end := len(flags)
 	if end == 0 {
 		return
@@ -109,6 +121,12 @@ func splitFlags(flags string) (align, sign, radix, zeroPad, minWidth, precision,
 			}
 			minWidth = flags[i:j]
 			i = j
+			state = groupingState
+		case groupingState:
+			if flags[i] == ',' {
+				grouping = flags[i : i+1]
+				i += 1
+			}
 			state = precisionState
 		case precisionState:
 			if flags[i] == '.' {
@@ -143,10 +161,11 @@ func splitFlags(flags string) (align, sign, radix, zeroPad, minWidth, precision,
 
 func (r *render) parseFlags(flags string) error {
 	r.renderVerb = "v"
+	r.verb = "v"
 	if flags == "" {
 		return nil
 	}
-	align, sign, radix, zeroPad, minWidth, precision, verb, err := splitFlags(flags)
+	align, sign, radix, zeroPad, minWidth, grouping, precision, verb, err := splitFlags(flags)
 	if err != nil {
 		return Error("Invalid flag pattern: {}, {}", flags, err)
 	}
@@ -189,10 +208,14 @@ func (r *render) parseFlags(flags string) error {
 	if minWidth != "" {
 		r.minWidth = minWidth
 	}
+	if grouping != "" {
+		r.grouping = grouping
+	}
 	if precision != "" {
 		r.precision = precision
 	}
 	if verb != "" {
+		r.verb = verb
 		switch verb {
 		case "b", "o", "x", "X", "e", "E", "f", "F", "g", "G":
 			r.renderVerb = verb
@@ -221,6 +244,9 @@ func (r *render) render() error {
 	var prefix, radix string
 	var width int64
 	var err error
+	if fmtr, ok := r.val.(Formatter); ok {
+		return r.renderFormatter(fmtr)
+	}
 	if r.percent {
 		if err = r.setupPercent(); err != nil {
 			return err
@@ -245,9 +271,16 @@ func (r *render) render() error {
 		}
 	}
 
+	// zeroFillGroup is true when zero-fill padding and "," grouping are both active. Grouping
+	// separators count against the requested width, so neither Go's own zero-fill (for float
+	// verbs) nor a naive pad-after-group (for everything else) lands on the right answer; render
+	// below zero-extends the digit run itself before grouping it in that case.
+	zeroFillGroup := r.align == padSign && r.fillChar == '0' && r.grouping != "" && r.isLocaleVerb()
+
 	// Only let Go handle the width for floating+complex types, elsewhere the alignment rules are
-	// different.
-	if r.renderVerb != "f" && r.renderVerb != "F" && r.renderVerb != "g" && r.renderVerb != "G" && r.renderVerb != "e" && r.renderVerb != "E" {
+	// different. zeroFillGroup also bypasses Go's width handling, since Go can't account for the
+	// grouping separators we're about to add.
+	if (r.renderVerb != "f" && r.renderVerb != "F" && r.renderVerb != "g" && r.renderVerb != "G" && r.renderVerb != "e" && r.renderVerb != "E") || zeroFillGroup {
 		r.minWidth = ""
 	}
 
@@ -285,6 +318,21 @@ func (r *render) render() error {
 		}
 	}
 
+	if r.isLocaleVerb() && (r.grouping != "" || r.locale != nil) {
+		loc := defaultLocale
+		if r.locale != nil {
+			loc = *r.locale
+		}
+		zeroFillWidth := 0
+		if zeroFillGroup {
+			zeroFillWidth = int(width)
+		}
+		str = applyLocale(str, loc, r.grouping != "", zeroFillWidth)
+		if r.percent && loc.percent != "%" {
+			str = strings.TrimSuffix(str, "%") + loc.percent
+		}
+	}
+
 	if len(str) > 0 {
 		if str[0] != '(' && (r.align == left || r.align == padSign) {
 			if str[0] == '-' {
@@ -314,6 +362,56 @@ func (r *render) render() error {
 	return nil
 }
 
+// isLocaleVerb reports whether the verb currently selected renders a number that locale-specific
+// separators and grouping should apply to. Bases other than decimal (b, o, x, X) and non-numeric
+// conversions (r, s, t) are left alone.
+func (r *render) isLocaleVerb() bool {
+	switch r.renderVerb {
+	case "d", "f", "F", "g", "G", "e", "E":
+		return true
+	case "v":
+		return isNumericKind(r.val)
+	}
+	return false
+}
+
+func isNumericKind(val interface{}) bool {
+	switch reflect.ValueOf(val).Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// renderFormatter dispatches to a value's own pyfmt.Formatter implementation instead of going
+// through fmt.Sprintf, passing it the parsed width/precision/fill/align/flags.
+func (r *render) renderFormatter(fmtr Formatter) error {
+	state := &formatState{
+		buf:   r.buf,
+		fill:  r.fillChar,
+		align: r.align,
+		sign:  r.sign,
+		radix: r.showRadix,
+	}
+	if r.minWidth != "" {
+		width, err := strconv.ParseInt(r.minWidth, 10, 64)
+		if err != nil {
+			return Error("Can't convert width {} to int", r.minWidth)
+		}
+		state.width, state.hasWidth = width, true
+	}
+	if r.precision != "" {
+		precision, err := strconv.ParseInt(r.precision[1:], 10, 64)
+		if err != nil {
+			return Error("Can't convert precision {} to int", r.precision)
+		}
+		state.precision, state.hasPrecision = precision, true
+	}
+	return fmtr.PyFormat(state, r.verb)
+}
+
 func (r *render) setupPercent() error {
 	// Increase the precision by two, to make sure we have enough digits.
 	if r.precision == "" {